@@ -0,0 +1,77 @@
+package cli
+
+import "testing"
+
+func TestFlagSetShortAndLongFormsShareValue(t *testing.T) {
+	fs := NewFlagSet("add")
+	title := fs.String("title", "t", "", "book title")
+
+	if err := fs.Parse([]string{"-t", "Dune"}); err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if *title != "Dune" {
+		t.Fatalf("title = %q; want %q", *title, "Dune")
+	}
+}
+
+func TestFlagSetRequiredMissing(t *testing.T) {
+	fs := NewFlagSet("add")
+	fs.String("title", "t", "", "book title")
+	fs.Require("title")
+
+	if err := fs.Parse(nil); err == nil {
+		t.Fatalf("Parse returned nil error for missing required flag")
+	}
+}
+
+func TestFlagSetRequiredSatisfiedViaShortForm(t *testing.T) {
+	fs := NewFlagSet("add")
+	fs.String("title", "t", "", "book title")
+	fs.Require("title")
+
+	if err := fs.Parse([]string{"-t", "Dune"}); err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+}
+
+func TestFlagSetArgs(t *testing.T) {
+	fs := NewFlagSet("borrow")
+	fs.Parse([]string{"42"})
+
+	if got := fs.Args(); len(got) != 1 || got[0] != "42" {
+		t.Fatalf("Args() = %v; want [42]", got)
+	}
+}
+
+type stubRunner struct {
+	name string
+	ran  []string
+}
+
+func (s *stubRunner) Name() string    { return s.name }
+func (s *stubRunner) Summary() string { return "stub command" }
+func (s *stubRunner) Run(args []string) error {
+	s.ran = args
+	return nil
+}
+
+func TestRegistryDispatch(t *testing.T) {
+	reg := NewRegistry("libmgr")
+	add := &stubRunner{name: "add"}
+	reg.Register(add)
+
+	if err := reg.Run([]string{"add", "--title=Dune"}); err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if len(add.ran) != 1 || add.ran[0] != "--title=Dune" {
+		t.Fatalf("add.ran = %v; want [--title=Dune]", add.ran)
+	}
+}
+
+func TestRegistryUnknownCommand(t *testing.T) {
+	reg := NewRegistry("libmgr")
+
+	if err := reg.Run([]string{"frobnicate"}); err == nil {
+		t.Fatalf("Run returned nil error for unknown command")
+	}
+}
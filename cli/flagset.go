@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// flagInfo records the long/short names and metadata for one flag,
+// independent of its type, so Usage can render them uniformly.
+type flagInfo struct {
+	long     string
+	short    string
+	usage    string
+	required bool
+}
+
+// FlagSet is a thin wrapper around flag.FlagSet that additionally supports
+// short aliases (-t as well as --title), required flags, and an
+// auto-generated usage string. It deliberately stays minimal rather than
+// pulling in a full-featured flags library.
+type FlagSet struct {
+	name  string
+	fs    *flag.FlagSet
+	flags []*flagInfo
+}
+
+// NewFlagSet returns a FlagSet for the named subcommand.
+func NewFlagSet(name string) *FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(new(strings.Builder)) // usage is rendered by FlagSet.Usage instead
+	return &FlagSet{name: name, fs: fs}
+}
+
+// String registers a string flag under both long ("title") and short ("t")
+// names, returning a pointer updated by Parse.
+func (f *FlagSet) String(long, short, def, usage string) *string {
+	p := new(string)
+	f.fs.StringVar(p, long, def, usage)
+	if short != "" {
+		f.fs.StringVar(p, short, def, usage)
+	}
+	f.flags = append(f.flags, &flagInfo{long: long, short: short, usage: usage})
+	return p
+}
+
+// Int registers an int flag under both long and short names.
+func (f *FlagSet) Int(long, short string, def int, usage string) *int {
+	p := new(int)
+	f.fs.IntVar(p, long, def, usage)
+	if short != "" {
+		f.fs.IntVar(p, short, def, usage)
+	}
+	f.flags = append(f.flags, &flagInfo{long: long, short: short, usage: usage})
+	return p
+}
+
+// Bool registers a bool flag under both long and short names.
+func (f *FlagSet) Bool(long, short string, def bool, usage string) *bool {
+	p := new(bool)
+	f.fs.BoolVar(p, long, def, usage)
+	if short != "" {
+		f.fs.BoolVar(p, short, def, usage)
+	}
+	f.flags = append(f.flags, &flagInfo{long: long, short: short, usage: usage})
+	return p
+}
+
+// Require marks long as mandatory: Parse fails if it was never set on the
+// command line.
+func (f *FlagSet) Require(long string) {
+	for _, info := range f.flags {
+		if info.long == long {
+			info.required = true
+			return
+		}
+	}
+}
+
+// ErrHelp is returned by Parse when -h/--help was requested.
+var ErrHelp = flag.ErrHelp
+
+// Parse parses args, then verifies every required flag was set. It returns
+// ErrHelp if -h/--help was passed.
+func (f *FlagSet) Parse(args []string) error {
+	if err := f.fs.Parse(args); err != nil {
+		return err
+	}
+
+	set := make(map[string]bool)
+	f.fs.Visit(func(fl *flag.Flag) { set[fl.Name] = true })
+
+	var missing []string
+	for _, info := range f.flags {
+		if !info.required {
+			continue
+		}
+		if set[info.long] || (info.short != "" && set[info.short]) {
+			continue
+		}
+		missing = append(missing, "--"+info.long)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s: missing required flag(s): %s", f.name, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Args returns the non-flag arguments remaining after Parse.
+func (f *FlagSet) Args() []string { return f.fs.Args() }
+
+// Usage renders a --help-style description of every registered flag.
+func (f *FlagSet) Usage() string {
+	infos := make([]*flagInfo, len(f.flags))
+	copy(infos, f.flags)
+	sort.Slice(infos, func(i, j int) bool { return infos[i].long < infos[j].long })
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Usage of %s:\n", f.name)
+	for _, info := range infos {
+		names := "--" + info.long
+		if info.short != "" {
+			names += ", -" + info.short
+		}
+		req := ""
+		if info.required {
+			req = " (required)"
+		}
+		fmt.Fprintf(&b, "  %s\n\t%s%s\n", names, info.usage, req)
+	}
+	return b.String()
+}
@@ -0,0 +1,73 @@
+// Package cli implements a small subcommand-driven argument parser,
+// modeled on minimal flag libraries rather than a large third-party
+// dependency: each subcommand is a Runner, registered with a Registry that
+// dispatches os.Args and renders --help.
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Runner is a single subcommand (e.g. "add", "list", "serve"). New
+// subcommands are added by implementing Runner and registering an instance
+// with a Registry — main never needs to change.
+type Runner interface {
+	// Name is the subcommand word the user types, e.g. "add".
+	Name() string
+	// Summary is a one-line description shown in the top-level --help.
+	Summary() string
+	// Run executes the subcommand with its remaining arguments (i.e.
+	// os.Args[2:]).
+	Run(args []string) error
+}
+
+// Registry holds every known subcommand and dispatches to the one the user
+// asked for.
+type Registry struct {
+	program string
+	runners map[string]Runner
+}
+
+// NewRegistry returns an empty Registry for the named program, used in
+// usage text.
+func NewRegistry(program string) *Registry {
+	return &Registry{program: program, runners: make(map[string]Runner)}
+}
+
+// Register adds r to the registry, keyed by r.Name().
+func (reg *Registry) Register(r Runner) {
+	reg.runners[r.Name()] = r
+}
+
+// Run dispatches args[0] to the matching Runner's Run with args[1:]. If
+// args is empty or requests help, Run prints top-level usage instead.
+func (reg *Registry) Run(args []string) error {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		fmt.Print(reg.Usage())
+		return nil
+	}
+
+	r, ok := reg.runners[args[0]]
+	if !ok {
+		return fmt.Errorf("%s: unknown command %q (see --help)", reg.program, args[0])
+	}
+	return r.Run(args[1:])
+}
+
+// Usage renders a command list for the top-level --help.
+func (reg *Registry) Usage() string {
+	names := make([]string, 0, len(reg.runners))
+	for name := range reg.runners {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Usage: %s <command> [flags]\n\nCommands:\n", reg.program)
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %-12s %s\n", name, reg.runners[name].Summary())
+	}
+	return b.String()
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/cli"
+)
+
+// AddCommand implements "libmgr add --title=... --author=... --year=...".
+type AddCommand struct{}
+
+func (AddCommand) Name() string    { return "add" }
+func (AddCommand) Summary() string { return "Add a book to the catalog" }
+
+func (AddCommand) Run(args []string) error {
+	fs := cli.NewFlagSet("add")
+	title := fs.String("title", "t", "", "book title")
+	author := fs.String("author", "a", "", "book author")
+	year := fs.Int("year", "y", 0, "publication year")
+	fs.Require("title")
+	fs.Require("author")
+	fs.Require("year")
+
+	if err := fs.Parse(args); err != nil {
+		if err == cli.ErrHelp {
+			fmt.Print(fs.Usage())
+			return nil
+		}
+		return err
+	}
+
+	lib, err := openCatalog()
+	if err != nil {
+		return err
+	}
+
+	id, err := lib.AddBook(*title, *author, *year)
+	if err != nil {
+		return err
+	}
+	saveCatalog(lib)
+
+	fmt.Printf("Added %q with ID %d.\n", *title, id)
+	return nil
+}
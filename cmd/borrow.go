@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/cli"
+)
+
+// BorrowCommand implements "libmgr borrow <id>".
+type BorrowCommand struct{}
+
+func (BorrowCommand) Name() string    { return "borrow" }
+func (BorrowCommand) Summary() string { return "Borrow a book by ID" }
+
+func (BorrowCommand) Run(args []string) error {
+	fs := cli.NewFlagSet("borrow")
+	if err := fs.Parse(args); err != nil {
+		if err == cli.ErrHelp {
+			fmt.Print(fs.Usage())
+			return nil
+		}
+		return err
+	}
+
+	id, err := singleIDArg(fs, "borrow")
+	if err != nil {
+		return err
+	}
+
+	lib, err := openCatalog()
+	if err != nil {
+		return err
+	}
+	if err := lib.BorrowBook(id); err != nil {
+		return err
+	}
+	saveCatalog(lib)
+
+	fmt.Println("Book borrowed.")
+	return nil
+}
+
+func singleIDArg(fs *cli.FlagSet, command string) (int, error) {
+	rest := fs.Args()
+	if len(rest) != 1 {
+		return 0, fmt.Errorf("usage: libmgr %s <id>", command)
+	}
+	return strconv.Atoi(rest[0])
+}
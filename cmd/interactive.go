@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/library"
+	"github.com/GlitchGuru19/MiniLibraryManager/ui"
+)
+
+// InteractiveCommand implements "libmgr interactive", the menu-driven mode
+// kept for backward compatibility with the original numeric-menu CLI.
+type InteractiveCommand struct{}
+
+func (InteractiveCommand) Name() string    { return "interactive" }
+func (InteractiveCommand) Summary() string { return "Run the interactive menu (legacy mode)" }
+
+func (InteractiveCommand) Run(args []string) error {
+	lib, err := openCatalog()
+	if err != nil {
+		return err
+	}
+	defer saveCatalog(lib)
+
+	backend, err := ui.NewTerminalBackend()
+	if err != nil {
+		return fmt.Errorf("interactive: could not start terminal UI: %w", err)
+	}
+	defer backend.Close()
+
+	runInteractive(backend, lib)
+	return nil
+}
+
+var menuItems = []string{
+	"Add Book",
+	"List Books",
+	"Borrow Book",
+	"Return Book",
+	"Search Books",
+	"Remove Book",
+	"Import CSV",
+	"Export CSV",
+	"Exit",
+}
+
+// runInteractive drives the menu loop against backend until the user
+// selects Exit or cancels out of the menu (e.g. Escape).
+func runInteractive(backend ui.Backend, lib *library.Library) {
+	for {
+		choice, err := ui.SelectFromList(backend, "Welcome to the Mini Library Manager", menuItems)
+		if err != nil {
+			return
+		}
+
+		switch menuItems[choice] {
+		case "Add Book":
+			interactiveAddBook(backend, lib)
+		case "List Books":
+			interactiveListBooks(backend, lib)
+		case "Borrow Book":
+			interactiveBorrowBook(backend, lib)
+		case "Return Book":
+			interactiveReturnBook(backend, lib)
+		case "Search Books":
+			interactiveSearchBooks(backend, lib)
+		case "Remove Book":
+			interactiveRemoveBook(backend, lib)
+		case "Import CSV":
+			interactiveImportCSV(backend, lib)
+		case "Export CSV":
+			interactiveExportCSV(backend, lib)
+		case "Exit":
+			backend.Write("Thank you for using the system.\n")
+			return
+		}
+	}
+}
+
+func interactiveAddBook(backend ui.Backend, lib *library.Library) {
+	title, err := ui.InputText(backend, "Title: ")
+	if err != nil {
+		return
+	}
+	author, err := ui.InputText(backend, "Author: ")
+	if err != nil {
+		return
+	}
+	year, err := ui.InputInt(backend, "Year: ")
+	if err != nil {
+		return
+	}
+
+	id, err := lib.AddBook(title, author, year)
+	if err != nil {
+		backend.Write(fmt.Sprintf("Could not add book: %v\n", err))
+		return
+	}
+	backend.Write(fmt.Sprintf("Added %q with ID %d.\n", title, id))
+	saveCatalog(lib)
+}
+
+func interactiveListBooks(backend ui.Backend, lib *library.Library) {
+	rows := toRows(lib.ListBooks())
+	if len(rows) == 0 {
+		backend.Write("The catalog is empty.\n")
+		return
+	}
+	ui.BookListView(backend, "Catalog (Enter to dismiss)", rows)
+}
+
+func interactiveBorrowBook(backend ui.Backend, lib *library.Library) {
+	id, ok := pickBook(backend, lib, "Borrow which book?")
+	if !ok {
+		return
+	}
+	if err := lib.BorrowBook(id); err != nil {
+		printLibraryError(backend, err)
+		return
+	}
+	backend.Write("Book borrowed.\n")
+	saveCatalog(lib)
+}
+
+func interactiveReturnBook(backend ui.Backend, lib *library.Library) {
+	id, ok := pickBook(backend, lib, "Return which book?")
+	if !ok {
+		return
+	}
+	if err := lib.ReturnBook(id); err != nil {
+		printLibraryError(backend, err)
+		return
+	}
+	backend.Write("Book returned.\n")
+	saveCatalog(lib)
+}
+
+func interactiveRemoveBook(backend ui.Backend, lib *library.Library) {
+	id, ok := pickBook(backend, lib, "Remove which book?")
+	if !ok {
+		return
+	}
+	if err := lib.RemoveBook(id); err != nil {
+		printLibraryError(backend, err)
+		return
+	}
+	backend.Write("Book removed.\n")
+	saveCatalog(lib)
+}
+
+// pickBook shows the catalog and lets the user navigate to a book with the
+// arrow keys. ok is false if the catalog is empty or selection is
+// cancelled.
+func pickBook(backend ui.Backend, lib *library.Library, title string) (id int, ok bool) {
+	rows := toRows(lib.ListBooks())
+	if len(rows) == 0 {
+		backend.Write("The catalog is empty.\n")
+		return 0, false
+	}
+	id, err := ui.BookListView(backend, title, rows)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+func interactiveSearchBooks(backend ui.Backend, lib *library.Library) {
+	choice, err := ui.SelectFromList(backend, "Search by", []string{"Title", "Author"})
+	if err != nil {
+		return
+	}
+	query, err := ui.InputText(backend, "Search term: ")
+	if err != nil {
+		return
+	}
+
+	var results []library.Book
+	if choice == 0 {
+		results = lib.FindByTitle(query)
+	} else {
+		results = lib.FindByAuthor(query)
+	}
+
+	if len(results) == 0 {
+		backend.Write("No matches found.\n")
+		return
+	}
+	ui.BookListView(backend, "Matches (Enter to dismiss)", toRows(results))
+}
+
+func interactiveImportCSV(backend ui.Backend, lib *library.Library) {
+	path, err := ui.InputText(backend, "CSV file to import: ")
+	if err != nil {
+		return
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		backend.Write(fmt.Sprintf("Could not open file: %v\n", err))
+		return
+	}
+	defer f.Close()
+
+	if err := lib.ImportCSV(f); err != nil {
+		backend.Write(fmt.Sprintf("Could not import CSV: %v\n", err))
+		return
+	}
+	backend.Write("Import complete.\n")
+	saveCatalog(lib)
+}
+
+func interactiveExportCSV(backend ui.Backend, lib *library.Library) {
+	path, err := ui.InputText(backend, "CSV file to export to: ")
+	if err != nil {
+		return
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		backend.Write(fmt.Sprintf("Could not create file: %v\n", err))
+		return
+	}
+	defer f.Close()
+
+	if err := lib.ExportCSV(f); err != nil {
+		backend.Write(fmt.Sprintf("Could not export CSV: %v\n", err))
+		return
+	}
+	backend.Write("Export complete.\n")
+}
+
+func printLibraryError(backend ui.Backend, err error) {
+	switch {
+	case errors.Is(err, library.ErrNotFound):
+		backend.Write("No book with that ID exists.\n")
+	case errors.Is(err, library.ErrAlreadyBorrowed):
+		backend.Write("That book is already borrowed.\n")
+	case errors.Is(err, library.ErrNotBorrowed):
+		backend.Write("That book isn't currently borrowed.\n")
+	default:
+		backend.Write(fmt.Sprintf("Error: %v\n", err))
+	}
+}
+
+func toRows(books []library.Book) []ui.BookRow {
+	rows := make([]ui.BookRow, len(books))
+	for i, b := range books {
+		rows[i] = ui.BookRow{
+			ID:         b.ID,
+			Title:      b.Title,
+			Author:     b.Author,
+			Year:       b.Year,
+			IsBorrowed: b.IsBorrowed,
+		}
+	}
+	return rows
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/cli"
+	"github.com/GlitchGuru19/MiniLibraryManager/library"
+)
+
+// ListCommand implements "libmgr list [--borrowed|--available]".
+type ListCommand struct{}
+
+func (ListCommand) Name() string    { return "list" }
+func (ListCommand) Summary() string { return "List books in the catalog" }
+
+func (ListCommand) Run(args []string) error {
+	fs := cli.NewFlagSet("list")
+	borrowed := fs.Bool("borrowed", "b", false, "show only borrowed books")
+	available := fs.Bool("available", "A", false, "show only available books")
+
+	if err := fs.Parse(args); err != nil {
+		if err == cli.ErrHelp {
+			fmt.Print(fs.Usage())
+			return nil
+		}
+		return err
+	}
+
+	lib, err := openCatalog()
+	if err != nil {
+		return err
+	}
+
+	books := lib.ListBooks()
+	printBooks(filterByStatus(books, *borrowed, *available))
+	return nil
+}
+
+func filterByStatus(books []library.Book, borrowed, available bool) []library.Book {
+	if !borrowed && !available {
+		return books
+	}
+	var out []library.Book
+	for _, b := range books {
+		if borrowed && b.IsBorrowed {
+			out = append(out, b)
+		}
+		if available && !b.IsBorrowed {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+func printBooks(books []library.Book) {
+	if len(books) == 0 {
+		fmt.Println("No books to show.")
+		return
+	}
+	for _, b := range books {
+		status := "available"
+		if b.IsBorrowed {
+			status = "borrowed"
+		}
+		fmt.Printf("[%d] %s by %s (%d) - %s\n", b.ID, b.Title, b.Author, b.Year, status)
+	}
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/cli"
+)
+
+// ReturnCommand implements "libmgr return <id>".
+type ReturnCommand struct{}
+
+func (ReturnCommand) Name() string    { return "return" }
+func (ReturnCommand) Summary() string { return "Return a borrowed book by ID" }
+
+func (ReturnCommand) Run(args []string) error {
+	fs := cli.NewFlagSet("return")
+	if err := fs.Parse(args); err != nil {
+		if err == cli.ErrHelp {
+			fmt.Print(fs.Usage())
+			return nil
+		}
+		return err
+	}
+
+	id, err := singleIDArg(fs, "return")
+	if err != nil {
+		return err
+	}
+
+	lib, err := openCatalog()
+	if err != nil {
+		return err
+	}
+	if err := lib.ReturnBook(id); err != nil {
+		return err
+	}
+	saveCatalog(lib)
+
+	fmt.Println("Book returned.")
+	return nil
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/cli"
+)
+
+// SearchCommand implements "libmgr search --author=... / --title=...".
+type SearchCommand struct{}
+
+func (SearchCommand) Name() string    { return "search" }
+func (SearchCommand) Summary() string { return "Search the catalog by title or author" }
+
+func (SearchCommand) Run(args []string) error {
+	fs := cli.NewFlagSet("search")
+	title := fs.String("title", "t", "", "search by title")
+	author := fs.String("author", "a", "", "search by author")
+
+	if err := fs.Parse(args); err != nil {
+		if err == cli.ErrHelp {
+			fmt.Print(fs.Usage())
+			return nil
+		}
+		return err
+	}
+
+	if *title == "" && *author == "" {
+		return fmt.Errorf("search: one of --title or --author is required")
+	}
+
+	lib, err := openCatalog()
+	if err != nil {
+		return err
+	}
+
+	if *title != "" {
+		printBooks(lib.FindByTitle(*title))
+	}
+	if *author != "" {
+		printBooks(lib.FindByAuthor(*author))
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/cli"
+	"github.com/GlitchGuru19/MiniLibraryManager/server"
+)
+
+// ServeCommand implements "libmgr serve --port=8080".
+type ServeCommand struct{}
+
+func (ServeCommand) Name() string    { return "serve" }
+func (ServeCommand) Summary() string { return "Serve the catalog over a REST API" }
+
+func (ServeCommand) Run(args []string) error {
+	fs := cli.NewFlagSet("serve")
+	port := fs.Int("port", "p", 8080, "port to listen on")
+	adminToken := fs.String("admin-token", "", os.Getenv("LIBMGR_ADMIN_TOKEN"), "bearer token for admin endpoints and API access")
+
+	if err := fs.Parse(args); err != nil {
+		if err == cli.ErrHelp {
+			fmt.Print(fs.Usage())
+			return nil
+		}
+		return err
+	}
+
+	if *adminToken == "" {
+		return fmt.Errorf("serve: --admin-token (or LIBMGR_ADMIN_TOKEN) is required")
+	}
+
+	lib, err := openCatalog()
+	if err != nil {
+		return err
+	}
+
+	s := server.NewServer(lib, CatalogPath, *adminToken)
+	addr := fmt.Sprintf(":%d", *port)
+	fmt.Printf("Serving library API on %s\n", addr)
+	return http.ListenAndServe(addr, s.Handler())
+}
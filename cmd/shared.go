@@ -0,0 +1,33 @@
+// Package cmd implements the individual libmgr subcommands as cli.Runner
+// values, so main can register them without knowing how any one of them
+// works.
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/library"
+)
+
+// CatalogPath is the default location the catalog is loaded from and saved
+// to.
+const CatalogPath = "library.json"
+
+// openCatalog loads CatalogPath into a fresh Library, tolerating a missing
+// file (a brand new catalog).
+func openCatalog() (*library.Library, error) {
+	lib := library.New()
+	if err := lib.Load(CatalogPath); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return lib, nil
+}
+
+// saveCatalog persists lib to CatalogPath, reporting any error without
+// aborting the caller.
+func saveCatalog(lib *library.Library) {
+	if err := lib.Save(CatalogPath); err != nil {
+		fmt.Println("Could not save catalog:", err)
+	}
+}
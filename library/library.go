@@ -0,0 +1,161 @@
+// Package library implements the core catalog model for the Mini Library
+// Manager: books, borrowing state, and search.
+package library
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+// ErrNotFound is returned when a requested book ID does not exist in the
+// catalog.
+var ErrNotFound = errors.New("library: book not found")
+
+// ErrAlreadyBorrowed is returned when attempting to borrow a book that is
+// already checked out.
+var ErrAlreadyBorrowed = errors.New("library: book already borrowed")
+
+// ErrNotBorrowed is returned when attempting to return a book that is not
+// currently checked out.
+var ErrNotBorrowed = errors.New("library: book not borrowed")
+
+// Book represents a single catalog entry.
+type Book struct {
+	ID         int
+	Title      string
+	Author     string
+	Year       int
+	IsBorrowed bool
+}
+
+// Library owns a catalog of books and provides CRUD and search operations
+// over it. A Library is safe for concurrent use, e.g. from the server
+// package's HTTP handlers.
+type Library struct {
+	mu     sync.Mutex
+	books  []Book
+	nextID int
+}
+
+// New returns an empty Library ready for use.
+func New() *Library {
+	return &Library{nextID: 1}
+}
+
+// AddBook adds a new book to the catalog and returns its auto-incremented
+// ID.
+func (l *Library) AddBook(title, author string, year int) (id int, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := Book{
+		ID:     l.nextID,
+		Title:  title,
+		Author: author,
+		Year:   year,
+	}
+	l.books = append(l.books, b)
+	l.nextID++
+	return b.ID, nil
+}
+
+// ListBooks returns a copy of every book currently in the catalog.
+func (l *Library) ListBooks() []Book {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	out := make([]Book, len(l.books))
+	copy(out, l.books)
+	return out
+}
+
+// BorrowBook marks the book with the given ID as borrowed. It returns
+// ErrNotFound if no such book exists, or ErrAlreadyBorrowed if the book is
+// already checked out.
+func (l *Library) BorrowBook(id int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	i := l.indexOf(id)
+	if i == -1 {
+		return ErrNotFound
+	}
+	if l.books[i].IsBorrowed {
+		return ErrAlreadyBorrowed
+	}
+	l.books[i].IsBorrowed = true
+	return nil
+}
+
+// ReturnBook marks the book with the given ID as returned. It returns
+// ErrNotFound if no such book exists, or ErrNotBorrowed if the book was not
+// checked out.
+func (l *Library) ReturnBook(id int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	i := l.indexOf(id)
+	if i == -1 {
+		return ErrNotFound
+	}
+	if !l.books[i].IsBorrowed {
+		return ErrNotBorrowed
+	}
+	l.books[i].IsBorrowed = false
+	return nil
+}
+
+// RemoveBook deletes the book with the given ID from the catalog. It
+// returns ErrNotFound if no such book exists.
+func (l *Library) RemoveBook(id int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	i := l.indexOf(id)
+	if i == -1 {
+		return ErrNotFound
+	}
+	l.books = append(l.books[:i], l.books[i+1:]...)
+	return nil
+}
+
+// FindByTitle returns every book whose title contains q, case-insensitive.
+func (l *Library) FindByTitle(q string) []Book {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.filter(func(b Book) bool {
+		return strings.Contains(strings.ToLower(b.Title), strings.ToLower(q))
+	})
+}
+
+// FindByAuthor returns every book whose author contains q,
+// case-insensitive.
+func (l *Library) FindByAuthor(q string) []Book {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.filter(func(b Book) bool {
+		return strings.Contains(strings.ToLower(b.Author), strings.ToLower(q))
+	})
+}
+
+func (l *Library) filter(keep func(Book) bool) []Book {
+	var out []Book
+	for _, b := range l.books {
+		if keep(b) {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+func (l *Library) indexOf(id int) int {
+	for i, b := range l.books {
+		if b.ID == id {
+			return i
+		}
+	}
+	return -1
+}
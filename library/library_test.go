@@ -0,0 +1,139 @@
+package library
+
+import "testing"
+
+func TestAddBookAssignsIncrementingIDs(t *testing.T) {
+	l := New()
+
+	id1, err := l.AddBook("Dune", "Frank Herbert", 1965)
+	if err != nil {
+		t.Fatalf("AddBook returned error: %v", err)
+	}
+	id2, err := l.AddBook("Foundation", "Isaac Asimov", 1951)
+	if err != nil {
+		t.Fatalf("AddBook returned error: %v", err)
+	}
+
+	if id1 != 1 || id2 != 2 {
+		t.Fatalf("got ids %d, %d; want 1, 2", id1, id2)
+	}
+	if got := len(l.ListBooks()); got != 2 {
+		t.Fatalf("ListBooks returned %d books; want 2", got)
+	}
+}
+
+func TestListBooksReturnsCopy(t *testing.T) {
+	l := New()
+	l.AddBook("Dune", "Frank Herbert", 1965)
+
+	books := l.ListBooks()
+	books[0].Title = "Mutated"
+
+	if l.ListBooks()[0].Title != "Dune" {
+		t.Fatalf("ListBooks did not return an independent copy")
+	}
+}
+
+func TestBorrowBook(t *testing.T) {
+	l := New()
+	id, _ := l.AddBook("Dune", "Frank Herbert", 1965)
+
+	if err := l.BorrowBook(id); err != nil {
+		t.Fatalf("BorrowBook returned error: %v", err)
+	}
+
+	books := l.ListBooks()
+	if !books[0].IsBorrowed {
+		t.Fatalf("book not marked as borrowed")
+	}
+}
+
+func TestBorrowBookNotFound(t *testing.T) {
+	l := New()
+
+	if err := l.BorrowBook(99); err != ErrNotFound {
+		t.Fatalf("got error %v; want ErrNotFound", err)
+	}
+}
+
+func TestBorrowBookAlreadyBorrowed(t *testing.T) {
+	l := New()
+	id, _ := l.AddBook("Dune", "Frank Herbert", 1965)
+	l.BorrowBook(id)
+
+	if err := l.BorrowBook(id); err != ErrAlreadyBorrowed {
+		t.Fatalf("got error %v; want ErrAlreadyBorrowed", err)
+	}
+}
+
+func TestReturnBook(t *testing.T) {
+	l := New()
+	id, _ := l.AddBook("Dune", "Frank Herbert", 1965)
+	l.BorrowBook(id)
+
+	if err := l.ReturnBook(id); err != nil {
+		t.Fatalf("ReturnBook returned error: %v", err)
+	}
+	if l.ListBooks()[0].IsBorrowed {
+		t.Fatalf("book still marked as borrowed")
+	}
+}
+
+func TestReturnBookNotFound(t *testing.T) {
+	l := New()
+
+	if err := l.ReturnBook(99); err != ErrNotFound {
+		t.Fatalf("got error %v; want ErrNotFound", err)
+	}
+}
+
+func TestReturnBookNotBorrowed(t *testing.T) {
+	l := New()
+	id, _ := l.AddBook("Dune", "Frank Herbert", 1965)
+
+	if err := l.ReturnBook(id); err != ErrNotBorrowed {
+		t.Fatalf("got error %v; want ErrNotBorrowed", err)
+	}
+}
+
+func TestRemoveBook(t *testing.T) {
+	l := New()
+	id, _ := l.AddBook("Dune", "Frank Herbert", 1965)
+
+	if err := l.RemoveBook(id); err != nil {
+		t.Fatalf("RemoveBook returned error: %v", err)
+	}
+	if got := len(l.ListBooks()); got != 0 {
+		t.Fatalf("ListBooks returned %d books; want 0", got)
+	}
+}
+
+func TestRemoveBookNotFound(t *testing.T) {
+	l := New()
+
+	if err := l.RemoveBook(99); err != ErrNotFound {
+		t.Fatalf("got error %v; want ErrNotFound", err)
+	}
+}
+
+func TestFindByTitle(t *testing.T) {
+	l := New()
+	l.AddBook("Dune", "Frank Herbert", 1965)
+	l.AddBook("Foundation", "Isaac Asimov", 1951)
+
+	got := l.FindByTitle("dun")
+	if len(got) != 1 || got[0].Title != "Dune" {
+		t.Fatalf("FindByTitle(%q) = %v; want single Dune match", "dun", got)
+	}
+}
+
+func TestFindByAuthor(t *testing.T) {
+	l := New()
+	l.AddBook("Dune", "Frank Herbert", 1965)
+	l.AddBook("Foundation", "Isaac Asimov", 1951)
+
+	got := l.FindByAuthor("asimov")
+	if len(got) != 1 || got[0].Author != "Isaac Asimov" {
+		t.Fatalf("FindByAuthor(%q) = %v; want single Asimov match", "asimov", got)
+	}
+}
@@ -0,0 +1,135 @@
+package library
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// Save writes the catalog to path as JSON, using a tmp-file-then-rename
+// sequence so a crash mid-write never corrupts the existing file.
+func (l *Library) Save(path string) error {
+	l.mu.Lock()
+	data, err := json.MarshalIndent(l.books, "", "  ")
+	l.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// Load reads the catalog from path, replacing the current contents of l.
+// If path does not exist, Load returns the error from os.Open unchanged so
+// callers can check os.IsNotExist.
+func (l *Library) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var books []Book
+	if err := json.Unmarshal(data, &books); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.books = books
+	l.nextID = 1
+	for _, b := range books {
+		if b.ID >= l.nextID {
+			l.nextID = b.ID + 1
+		}
+	}
+	return nil
+}
+
+var csvHeader = []string{"ID", "Title", "Author", "Year", "IsBorrowed"}
+
+// ExportCSV writes the full catalog to w in CSV form, one row per book.
+func (l *Library) ExportCSV(w io.Writer) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, b := range l.books {
+		row := []string{
+			strconv.Itoa(b.ID),
+			b.Title,
+			b.Author,
+			strconv.Itoa(b.Year),
+			strconv.FormatBool(b.IsBorrowed),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportCSV bulk-loads books from r, which must have the header produced by
+// ExportCSV (Title, Author, and Year columns are required; ID and
+// IsBorrowed are ignored and reassigned). Each row is added via AddBook, so
+// existing books are preserved and IDs are freshly assigned.
+func (l *Library) ImportCSV(r io.Reader) error {
+	cr := csv.NewReader(r)
+	records, err := cr.ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	header := records[0]
+	col := make(map[string]int, len(header))
+	for i, name := range header {
+		col[name] = i
+	}
+	for _, required := range []string{"Title", "Author", "Year"} {
+		if _, ok := col[required]; !ok {
+			return fmt.Errorf("library: CSV header missing required column %q", required)
+		}
+	}
+
+	for _, row := range records[1:] {
+		title := row[col["Title"]]
+		author := row[col["Author"]]
+		year, err := strconv.Atoi(row[col["Year"]])
+		if err != nil {
+			return err
+		}
+		if _, err := l.AddBook(title, author, year); err != nil {
+			return err
+		}
+	}
+	return nil
+}
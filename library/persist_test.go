@@ -0,0 +1,86 @@
+package library
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	l := New()
+	l.AddBook("Dune", "Frank Herbert", 1965)
+	id, _ := l.AddBook("Foundation", "Isaac Asimov", 1951)
+	l.BorrowBook(id)
+
+	path := filepath.Join(t.TempDir(), "library.json")
+	if err := l.Save(path); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	loaded := New()
+	if err := loaded.Load(path); err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	books := loaded.ListBooks()
+	if len(books) != 2 {
+		t.Fatalf("Load restored %d books; want 2", len(books))
+	}
+	if !books[1].IsBorrowed {
+		t.Fatalf("Load did not preserve IsBorrowed state")
+	}
+
+	newID, err := loaded.AddBook("New Book", "Someone", 2020)
+	if err != nil {
+		t.Fatalf("AddBook returned error: %v", err)
+	}
+	if newID != 3 {
+		t.Fatalf("AddBook after Load assigned ID %d; want 3 (nextID not restored)", newID)
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	l := New()
+	path := filepath.Join(t.TempDir(), "does-not-exist.json")
+
+	if err := l.Load(path); err == nil {
+		t.Fatalf("Load returned nil error for missing file")
+	}
+}
+
+func TestExportImportCSVRoundTrip(t *testing.T) {
+	l := New()
+	l.AddBook("Dune", "Frank Herbert", 1965)
+	l.AddBook("Foundation", "Isaac Asimov", 1951)
+
+	var buf bytes.Buffer
+	if err := l.ExportCSV(&buf); err != nil {
+		t.Fatalf("ExportCSV returned error: %v", err)
+	}
+
+	imported := New()
+	if err := imported.ImportCSV(strings.NewReader(buf.String())); err != nil {
+		t.Fatalf("ImportCSV returned error: %v", err)
+	}
+
+	books := imported.ListBooks()
+	if len(books) != 2 {
+		t.Fatalf("ImportCSV loaded %d books; want 2", len(books))
+	}
+	if books[0].Title != "Dune" || books[1].Author != "Isaac Asimov" {
+		t.Fatalf("ImportCSV produced unexpected books: %+v", books)
+	}
+}
+
+func TestImportCSVMissingRequiredColumn(t *testing.T) {
+	l := New()
+	csv := "ID,Name,Author,Year,IsBorrowed\n1,Dune,Frank Herbert,1965,false\n"
+
+	if err := l.ImportCSV(strings.NewReader(csv)); err == nil {
+		t.Fatalf("ImportCSV returned nil error for header missing Title column")
+	}
+	if len(l.ListBooks()) != 0 {
+		t.Fatalf("ImportCSV added books despite invalid header")
+	}
+}
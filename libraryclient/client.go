@@ -0,0 +1,141 @@
+// Package libraryclient is a Go client for the REST API exposed by the
+// server package, letting other programs drive a remote library instance
+// the same way the CLI drives a local one.
+package libraryclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/library"
+)
+
+// Client talks to a remote library server over HTTP, authenticating with a
+// bearer token.
+type Client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// NewClient returns a Client for the server at baseURL, authenticating
+// requests with token.
+func NewClient(baseURL, token string) *Client {
+	return &Client{baseURL: baseURL, token: token, http: http.DefaultClient}
+}
+
+// Books returns every book in the remote catalog.
+func (c *Client) Books() ([]library.Book, error) {
+	var books []library.Book
+	if err := c.do(http.MethodGet, "/books", nil, &books); err != nil {
+		return nil, err
+	}
+	return books, nil
+}
+
+// SearchByTitle returns every remote book whose title matches q.
+func (c *Client) SearchByTitle(q string) ([]library.Book, error) {
+	return c.search("title", q)
+}
+
+// SearchByAuthor returns every remote book whose author matches q.
+func (c *Client) SearchByAuthor(q string) ([]library.Book, error) {
+	return c.search("author", q)
+}
+
+func (c *Client) search(field, q string) ([]library.Book, error) {
+	path := "/books?" + url.Values{field: {q}}.Encode()
+	var books []library.Book
+	if err := c.do(http.MethodGet, path, nil, &books); err != nil {
+		return nil, err
+	}
+	return books, nil
+}
+
+// AddBook creates a book on the remote catalog and returns its ID.
+func (c *Client) AddBook(title, author string, year int) (id int, err error) {
+	body := struct {
+		Title  string `json:"title"`
+		Author string `json:"author"`
+		Year   int    `json:"year"`
+	}{title, author, year}
+
+	var resp struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(http.MethodPost, "/books", body, &resp); err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+// BorrowBook marks the remote book with the given ID as borrowed.
+func (c *Client) BorrowBook(id int) error {
+	return c.do(http.MethodPost, "/books/"+strconv.Itoa(id)+"/borrow", nil, nil)
+}
+
+// ReturnBook marks the remote book with the given ID as returned.
+func (c *Client) ReturnBook(id int) error {
+	return c.do(http.MethodPost, "/books/"+strconv.Itoa(id)+"/return", nil, nil)
+}
+
+// RemoveBook deletes the remote book with the given ID.
+func (c *Client) RemoveBook(id int) error {
+	return c.do(http.MethodDelete, "/books/"+strconv.Itoa(id), nil, nil)
+}
+
+// Export writes the remote catalog, JSON-encoded, to w.
+func (c *Client) Export(w io.Writer) error {
+	books, err := c.Books()
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(books)
+}
+
+func (c *Client) do(method, path string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		if apiErr.Error != "" {
+			return fmt.Errorf("libraryclient: %s", apiErr.Error)
+		}
+		return fmt.Errorf("libraryclient: unexpected status %d", resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
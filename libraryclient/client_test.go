@@ -0,0 +1,104 @@
+package libraryclient
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/library"
+	"github.com/GlitchGuru19/MiniLibraryManager/server"
+)
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "library.json")
+	s := server.NewServer(library.New(), path, "admin-secret")
+	srv := httptest.NewServer(s.Handler())
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL, "admin-secret")
+}
+
+func TestAddBookAndBooks(t *testing.T) {
+	c := newTestClient(t)
+
+	id, err := c.AddBook("Dune", "Frank Herbert", 1965)
+	if err != nil {
+		t.Fatalf("AddBook returned error: %v", err)
+	}
+	if id == 0 {
+		t.Fatalf("AddBook returned zero id")
+	}
+
+	books, err := c.Books()
+	if err != nil {
+		t.Fatalf("Books returned error: %v", err)
+	}
+	if len(books) != 1 || books[0].Title != "Dune" {
+		t.Fatalf("Books = %+v; want single Dune entry", books)
+	}
+}
+
+func TestBorrowAndReturnBook(t *testing.T) {
+	c := newTestClient(t)
+	id, _ := c.AddBook("Dune", "Frank Herbert", 1965)
+
+	if err := c.BorrowBook(id); err != nil {
+		t.Fatalf("BorrowBook returned error: %v", err)
+	}
+	if err := c.ReturnBook(id); err != nil {
+		t.Fatalf("ReturnBook returned error: %v", err)
+	}
+}
+
+func TestRemoveBook(t *testing.T) {
+	c := newTestClient(t)
+	id, _ := c.AddBook("Dune", "Frank Herbert", 1965)
+
+	if err := c.RemoveBook(id); err != nil {
+		t.Fatalf("RemoveBook returned error: %v", err)
+	}
+	books, _ := c.Books()
+	if len(books) != 0 {
+		t.Fatalf("Books after RemoveBook = %+v; want empty", books)
+	}
+}
+
+func TestSearchByAuthor(t *testing.T) {
+	c := newTestClient(t)
+	c.AddBook("Dune", "Frank Herbert", 1965)
+	c.AddBook("Foundation", "Isaac Asimov", 1951)
+
+	got, err := c.SearchByAuthor("asimov")
+	if err != nil {
+		t.Fatalf("SearchByAuthor returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].Author != "Isaac Asimov" {
+		t.Fatalf("SearchByAuthor = %+v; want single Asimov match", got)
+	}
+}
+
+func TestExport(t *testing.T) {
+	c := newTestClient(t)
+	c.AddBook("Dune", "Frank Herbert", 1965)
+
+	var buf bytes.Buffer
+	if err := c.Export(&buf); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+	if buf.Len() == 0 {
+		t.Fatalf("Export wrote no data")
+	}
+}
+
+func TestBorrowBookUnauthorized(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "library.json")
+	s := server.NewServer(library.New(), path, "admin-secret")
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "wrong-token")
+	if _, err := c.Books(); err == nil {
+		t.Fatalf("Books with wrong token returned nil error")
+	}
+}
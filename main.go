@@ -7,9 +7,10 @@ complete catalog, borrowing books, and returning previously borrowed items.
 
 Features:
 
-- Interactive command-line menu system
+- Subcommand-driven CLI (add, list, borrow, return, search, serve)
+- An "interactive" subcommand preserving the original menu-driven mode
 - Book status tracking (available/borrowed)
-- Input validation and error handling
+- JSON persistence and CSV import/export
 
 Author: Glitch Guru 19
 Version: 1.0
@@ -17,52 +18,26 @@ Version: 1.0
 
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"os"
 
-type Book struct {
-	Title      string
-	Author     string
-	Year       int
-	IsBorrowed bool
-}
-
-func displayMenu() {
-	fmt.Println("\nWelcome to the Mini Library Manager")
-	fmt.Println()
-	fmt.Println("Please select an option:")
-	fmt.Println("1. Add Book")
-	fmt.Println("2. List Books")
-	fmt.Println("3. Borrow Book")
-	fmt.Println("4. Return Book")
-	fmt.Println("5. Exit")
-}
+	"github.com/GlitchGuru19/MiniLibraryManager/cli"
+	"github.com/GlitchGuru19/MiniLibraryManager/cmd"
+)
 
 func main() {
-	var option int = 0
-	displayMenu()
-	fmt.Print("Please select an opion: ")
-	fmt.Scanln(&option)
-
-	for {
-		switch option {
-		case 1:
-			fmt.Println("\n📖 Add a New Book")
-			fmt.Println("-----------------")
-			return
-		case 2:
-			fmt.Println("List Books selected")
-			return
-		case 3:
-			fmt.Println("Borrow Book")
-			return
-		case 4:
-			fmt.Println("Return Book")
-			return
-		case 5:
-			fmt.Println("Thank you for using the system.")
-			return
-		default:
-			fmt.Println("Invalid option. Please try again.")
-		}
+	registry := cli.NewRegistry("libmgr")
+	registry.Register(cmd.AddCommand{})
+	registry.Register(cmd.ListCommand{})
+	registry.Register(cmd.BorrowCommand{})
+	registry.Register(cmd.ReturnCommand{})
+	registry.Register(cmd.SearchCommand{})
+	registry.Register(cmd.ServeCommand{})
+	registry.Register(cmd.InteractiveCommand{})
+
+	if err := registry.Run(os.Args[1:]); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,256 @@
+// Package server exposes a library.Library over a JSON REST API, guarded
+// by bearer-token auth.
+package server
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/library"
+)
+
+// Server adapts a library.Library to net/http. The zero value is not
+// usable; construct one with NewServer.
+type Server struct {
+	lib         *library.Library
+	catalogPath string
+	adminToken  string
+
+	mu     sync.Mutex
+	tokens map[string]bool
+}
+
+// NewServer returns a Server backed by lib, saving to catalogPath after
+// every mutation for crash-safety (the same guarantee the CLI gives).
+// adminToken must be presented as a bearer token to reach the /admin
+// endpoints, and is itself always accepted as a valid session token for the
+// /books endpoints.
+func NewServer(lib *library.Library, catalogPath, adminToken string) *Server {
+	return &Server{
+		lib:         lib,
+		catalogPath: catalogPath,
+		adminToken:  adminToken,
+		tokens:      make(map[string]bool),
+	}
+}
+
+// saveCatalog persists the catalog to catalogPath, reporting any error
+// without aborting the caller.
+func (s *Server) saveCatalog() {
+	if err := s.lib.Save(s.catalogPath); err != nil {
+		fmt.Println("Could not save catalog:", err)
+	}
+}
+
+// Handler returns the http.Handler serving the REST API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/books", s.requireSession(s.handleBooksCollection))
+	mux.HandleFunc("/books/", s.requireSession(s.handleBooksItem))
+	mux.HandleFunc("/admin/tokens", s.requireAdmin(s.handleIssueToken))
+	mux.HandleFunc("/admin/flush-sessions", s.requireAdmin(s.handleFlushSessions))
+	return mux
+}
+
+func (s *Server) handleBooksCollection(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		books := s.lib.ListBooks()
+		if title := r.URL.Query().Get("title"); title != "" {
+			books = s.lib.FindByTitle(title)
+		} else if author := r.URL.Query().Get("author"); author != "" {
+			books = s.lib.FindByAuthor(author)
+		}
+		writeJSON(w, http.StatusOK, books)
+	case http.MethodPost:
+		var req struct {
+			Title  string `json:"title"`
+			Author string `json:"author"`
+			Year   int    `json:"year"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+		id, err := s.lib.AddBook(req.Title, req.Author, req.Year)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.saveCatalog()
+		writeJSON(w, http.StatusCreated, struct {
+			ID int `json:"id"`
+		}{ID: id})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) handleBooksItem(w http.ResponseWriter, r *http.Request) {
+	rest := strings.TrimPrefix(r.URL.Path, "/books/")
+	parts := strings.Split(strings.Trim(rest, "/"), "/")
+
+	id, err := strconv.Atoi(parts[0])
+	if err != nil {
+		writeError(w, http.StatusNotFound, "invalid book id")
+		return
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		s.getBook(w, id)
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		s.removeBook(w, id)
+	case len(parts) == 2 && parts[1] == "borrow" && r.Method == http.MethodPost:
+		s.mutateBook(w, id, s.lib.BorrowBook)
+	case len(parts) == 2 && parts[1] == "return" && r.Method == http.MethodPost:
+		s.mutateBook(w, id, s.lib.ReturnBook)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) getBook(w http.ResponseWriter, id int) {
+	for _, b := range s.lib.ListBooks() {
+		if b.ID == id {
+			writeJSON(w, http.StatusOK, b)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, library.ErrNotFound.Error())
+}
+
+func (s *Server) removeBook(w http.ResponseWriter, id int) {
+	if err := s.lib.RemoveBook(id); err != nil {
+		writeError(w, errStatus(err), err.Error())
+		return
+	}
+	s.saveCatalog()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) mutateBook(w http.ResponseWriter, id int, mutate func(int) error) {
+	if err := mutate(id); err != nil {
+		writeError(w, errStatus(err), err.Error())
+		return
+	}
+	s.saveCatalog()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func errStatus(err error) int {
+	if err == library.ErrNotFound {
+		return http.StatusNotFound
+	}
+	return http.StatusConflict
+}
+
+// handleIssueToken generates a new session token valid for the /books
+// endpoints.
+func (s *Server) handleIssueToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	token, err := newToken()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not generate token")
+		return
+	}
+
+	s.mu.Lock()
+	s.tokens[token] = true
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, struct {
+		Token string `json:"token"`
+	}{Token: token})
+}
+
+// handleFlushSessions invalidates every token issued by handleIssueToken.
+// The admin token itself is unaffected.
+func (s *Server) handleFlushSessions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	s.mu.Lock()
+	s.tokens = make(map[string]bool)
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) requireSession(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := bearerToken(r)
+		if token == "" || !s.isValidSession(token) {
+			writeError(w, http.StatusUnauthorized, "missing or invalid bearer token")
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *Server) requireAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !tokensEqual(bearerToken(r), s.adminToken) {
+			writeError(w, http.StatusUnauthorized, "missing or invalid admin token")
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *Server) isValidSession(token string) bool {
+	if tokensEqual(token, s.adminToken) {
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens[token]
+}
+
+// tokensEqual compares two bearer tokens in constant time so a timing
+// attack can't be used to guess a valid token one byte at a time.
+func tokensEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+func bearerToken(r *http.Request) string {
+	h := r.Header.Get("Authorization")
+	const prefix = "Bearer "
+	if !strings.HasPrefix(h, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(h, prefix)
+}
+
+func newToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, struct {
+		Error string `json:"error"`
+	}{Error: message})
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GlitchGuru19/MiniLibraryManager/library"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "library.json")
+	return NewServer(library.New(), path, "admin-secret")
+}
+
+func TestBooksRequiresAuth(t *testing.T) {
+	s := newTestServer(t)
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/books")
+	if err != nil {
+		t.Fatalf("GET /books: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("status = %d; want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestAddAndListBooks(t *testing.T) {
+	s := newTestServer(t)
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/books", strings.NewReader(`{"title":"Dune","author":"Frank Herbert","year":1965}`))
+	req.Header.Set("Authorization", "Bearer admin-secret")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST /books: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("POST /books status = %d; want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, srv.URL+"/books", nil)
+	req.Header.Set("Authorization", "Bearer admin-secret")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET /books: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /books status = %d; want %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestBorrowAndReturnBook(t *testing.T) {
+	s := newTestServer(t)
+	id, _ := s.lib.AddBook("Dune", "Frank Herbert", 1965)
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	url := srv.URL + "/books/" + strconv.Itoa(id) + "/borrow"
+	req, _ := http.NewRequest(http.MethodPost, url, nil)
+	req.Header.Set("Authorization", "Bearer admin-secret")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST borrow: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("POST borrow status = %d; want %d", resp.StatusCode, http.StatusNoContent)
+	}
+
+	if err := s.lib.BorrowBook(id); err != library.ErrAlreadyBorrowed {
+		t.Fatalf("expected book to already be borrowed via API call")
+	}
+}
+
+func TestIssueTokenAndFlushSessions(t *testing.T) {
+	s := newTestServer(t)
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/admin/tokens", nil)
+	req.Header.Set("Authorization", "Bearer admin-secret")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST /admin/tokens: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("POST /admin/tokens status = %d; want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if len(s.tokens) != 1 {
+		t.Fatalf("expected 1 issued token, got %d", len(s.tokens))
+	}
+
+	req, _ = http.NewRequest(http.MethodPost, srv.URL+"/admin/flush-sessions", nil)
+	req.Header.Set("Authorization", "Bearer admin-secret")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST /admin/flush-sessions: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("POST /admin/flush-sessions status = %d; want %d", resp.StatusCode, http.StatusNoContent)
+	}
+	if len(s.tokens) != 0 {
+		t.Fatalf("expected tokens to be flushed, got %d remaining", len(s.tokens))
+	}
+}
+
+func TestMutationsPersistToCatalogPath(t *testing.T) {
+	s := newTestServer(t)
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/books", strings.NewReader(`{"title":"Dune","author":"Frank Herbert","year":1965}`))
+	req.Header.Set("Authorization", "Bearer admin-secret")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST /books: %v", err)
+	}
+	resp.Body.Close()
+
+	reloaded := library.New()
+	if err := reloaded.Load(s.catalogPath); err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if len(reloaded.ListBooks()) != 1 {
+		t.Fatalf("catalog file has %d books; want 1 (mutation not persisted)", len(reloaded.ListBooks()))
+	}
+}
+
+func TestConcurrentAddBookRequests(t *testing.T) {
+	s := newTestServer(t)
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	const n = 50
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodPost, srv.URL+"/books", strings.NewReader(`{"title":"Dune","author":"Frank Herbert","year":1965}`))
+			req.Header.Set("Authorization", "Bearer admin-secret")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Errorf("POST /books: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := len(s.lib.ListBooks()); got != n {
+		t.Fatalf("catalog has %d books; want %d", got, n)
+	}
+}
@@ -0,0 +1,39 @@
+// Package ui provides small terminal widgets (list selection, text/int
+// prompts, confirmations, and a scrollable book list) used by the
+// interactive CLI. Terminal I/O is routed through the Backend interface so
+// the widgets can be exercised in tests without a real tty.
+package ui
+
+// SpecialKey identifies non-printable keys a Backend can report.
+type SpecialKey int
+
+const (
+	// KeyNone indicates Key.Rune holds a printable character.
+	KeyNone SpecialKey = iota
+	KeyEnter
+	KeyEscape
+	KeyUp
+	KeyDown
+	KeyBackspace
+)
+
+// Key is a single input event read from a Backend. Special is KeyNone for
+// printable characters, in which case Rune holds the character.
+type Key struct {
+	Rune    rune
+	Special SpecialKey
+}
+
+// Backend is the terminal I/O surface the ui widgets are built on. The
+// default implementation, NewTerminalBackend, drives a real tty; tests use
+// a stub that feeds canned keys and records output.
+type Backend interface {
+	// ReadKey blocks for the next key event.
+	ReadKey() (Key, error)
+	// Write sends text to the terminal.
+	Write(s string)
+	// Size reports the current terminal dimensions in columns and rows.
+	Size() (width, height int)
+	// Close restores any terminal mode changed by the backend.
+	Close() error
+}
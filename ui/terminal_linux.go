@@ -0,0 +1,133 @@
+//go:build linux
+
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// termios mirrors the fields of struct termios needed to toggle raw mode;
+// it is not the full POSIX layout, only what TCGETS/TCSETS touch here.
+type termios struct {
+	Iflag, Oflag, Cflag, Lflag uint32
+	Line                       byte
+	Cc                         [32]byte
+	Ispeed, Ospeed             uint32
+}
+
+const (
+	tcgets = 0x5401
+	tcsets = 0x5402
+
+	iexten = 0x8000
+	icanon = 0x2
+	echo   = 0x8
+	isig   = 0x1
+
+	tiocgwinsz = 0x5413
+)
+
+type winsize struct {
+	Row, Col, Xpixel, Ypixel uint16
+}
+
+// TerminalBackend drives a real tty in raw mode, decoding arrow keys and
+// Enter/Escape/Backspace from the escape sequences a terminal sends.
+type TerminalBackend struct {
+	in       *os.File
+	out      *os.File
+	reader   *bufio.Reader
+	original termios
+	rawSet   bool
+}
+
+// NewTerminalBackend puts stdin into raw mode and returns a Backend over
+// the process's stdin/stdout. Callers must call Close to restore the
+// original terminal mode.
+func NewTerminalBackend() (*TerminalBackend, error) {
+	b := &TerminalBackend{in: os.Stdin, out: os.Stdout, reader: bufio.NewReader(os.Stdin)}
+
+	if err := ioctl(b.in.Fd(), tcgets, uintptr(unsafe.Pointer(&b.original))); err != nil {
+		// Not a real tty (e.g. piped input in tests/CI): fall back to
+		// line-buffered mode rather than failing outright.
+		return b, nil
+	}
+
+	raw := b.original
+	raw.Lflag &^= icanon | echo | isig | iexten
+	if err := ioctl(b.in.Fd(), tcsets, uintptr(unsafe.Pointer(&raw))); err != nil {
+		return nil, fmt.Errorf("ui: enable raw mode: %w", err)
+	}
+	b.rawSet = true
+	return b, nil
+}
+
+// Close restores the terminal's original mode.
+func (b *TerminalBackend) Close() error {
+	if !b.rawSet {
+		return nil
+	}
+	b.rawSet = false
+	return ioctl(b.in.Fd(), tcsets, uintptr(unsafe.Pointer(&b.original)))
+}
+
+func (b *TerminalBackend) Write(s string) {
+	fmt.Fprint(b.out, s)
+}
+
+func (b *TerminalBackend) Size() (width, height int) {
+	var ws winsize
+	if err := ioctl(b.out.Fd(), tiocgwinsz, uintptr(unsafe.Pointer(&ws))); err != nil {
+		return 80, 24
+	}
+	return int(ws.Col), int(ws.Row)
+}
+
+// ReadKey reads one key event, translating ANSI arrow-key escape sequences
+// (ESC [ A/B/C/D) into KeyUp/KeyDown and a bare ESC into KeyEscape.
+func (b *TerminalBackend) ReadKey() (Key, error) {
+	r, _, err := b.reader.ReadRune()
+	if err != nil {
+		return Key{}, err
+	}
+
+	switch r {
+	case '\r', '\n':
+		return Key{Special: KeyEnter}, nil
+	case 127, 8:
+		return Key{Special: KeyBackspace}, nil
+	case 27:
+		if b.reader.Buffered() == 0 {
+			return Key{Special: KeyEscape}, nil
+		}
+		second, _, err := b.reader.ReadRune()
+		if err != nil || second != '[' {
+			return Key{Special: KeyEscape}, nil
+		}
+		third, _, err := b.reader.ReadRune()
+		if err != nil {
+			return Key{Special: KeyEscape}, nil
+		}
+		switch third {
+		case 'A':
+			return Key{Special: KeyUp}, nil
+		case 'B':
+			return Key{Special: KeyDown}, nil
+		}
+		return Key{Special: KeyEscape}, nil
+	default:
+		return Key{Rune: r}, nil
+	}
+}
+
+func ioctl(fd uintptr, req uintptr, arg uintptr) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, req, arg)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+//go:build !linux
+
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+// TerminalBackend is a line-buffered fallback for platforms without the
+// raw-mode support implemented for Linux: it cannot report real arrow-key
+// events, so ReadKey returns "u"/"d" as plain runes and relies on
+// SelectFromList's "u"/"d" fallback for navigation. Enter and Backspace are
+// the only recognized control keys.
+type TerminalBackend struct {
+	out    *os.File
+	reader *bufio.Reader
+}
+
+// NewTerminalBackend returns a Backend over the process's stdin/stdout.
+func NewTerminalBackend() (*TerminalBackend, error) {
+	return &TerminalBackend{out: os.Stdout, reader: bufio.NewReader(os.Stdin)}, nil
+}
+
+// Close is a no-op: this backend never changes terminal mode.
+func (b *TerminalBackend) Close() error { return nil }
+
+func (b *TerminalBackend) Write(s string) {
+	fmt.Fprint(b.out, s)
+}
+
+func (b *TerminalBackend) Size() (width, height int) {
+	return 80, 24
+}
+
+func (b *TerminalBackend) ReadKey() (Key, error) {
+	r, _, err := b.reader.ReadRune()
+	if err != nil {
+		return Key{}, err
+	}
+	switch r {
+	case '\r', '\n':
+		return Key{Special: KeyEnter}, nil
+	case 127, 8:
+		return Key{Special: KeyBackspace}, nil
+	default:
+		return Key{Rune: r}, nil
+	}
+}
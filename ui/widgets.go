@@ -0,0 +1,134 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SelectFromList renders items as a navigable menu and returns the index
+// the user picked. Up/Down move the highlighted row, Enter confirms, and
+// Escape returns an error. Backends that cannot report real arrow keys
+// (see terminal_other.go) can still navigate with the "u"/"d" runes.
+func SelectFromList(backend Backend, title string, items []string) (int, error) {
+	selected := 0
+	for {
+		render(backend, title, items, selected)
+
+		key, err := backend.ReadKey()
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case key.Special == KeyUp || (key.Special == KeyNone && key.Rune == 'u'):
+			if selected > 0 {
+				selected--
+			}
+		case key.Special == KeyDown || (key.Special == KeyNone && key.Rune == 'd'):
+			if selected < len(items)-1 {
+				selected++
+			}
+		case key.Special == KeyEnter:
+			return selected, nil
+		case key.Special == KeyEscape:
+			return 0, fmt.Errorf("ui: selection cancelled")
+		}
+	}
+}
+
+func render(backend Backend, title string, items []string, selected int) {
+	var b strings.Builder
+	b.WriteString(title)
+	b.WriteString("\n")
+	for i, item := range items {
+		cursor := "  "
+		if i == selected {
+			cursor = "> "
+		}
+		fmt.Fprintf(&b, "%s%s\n", cursor, item)
+	}
+	backend.Write(b.String())
+}
+
+// InputText reads a single line of free-form text, honoring Backspace.
+func InputText(backend Backend, prompt string) (string, error) {
+	backend.Write(prompt)
+	var b strings.Builder
+	for {
+		key, err := backend.ReadKey()
+		if err != nil {
+			return "", err
+		}
+		switch key.Special {
+		case KeyEnter:
+			backend.Write("\n")
+			return b.String(), nil
+		case KeyBackspace:
+			s := b.String()
+			if len(s) > 0 {
+				b.Reset()
+				b.WriteString(s[:len(s)-1])
+				backend.Write("\b \b")
+			}
+		case KeyNone:
+			b.WriteRune(key.Rune)
+			backend.Write(string(key.Rune))
+		}
+	}
+}
+
+// InputInt reads a line of input and parses it as an integer, reprompting
+// on invalid input.
+func InputInt(backend Backend, prompt string) (int, error) {
+	for {
+		s, err := InputText(backend, prompt)
+		if err != nil {
+			return 0, err
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err == nil {
+			return n, nil
+		}
+		backend.Write("Please enter a whole number.\n")
+	}
+}
+
+// Confirm asks a yes/no question, defaulting to "no" on unrecognized
+// input.
+func Confirm(backend Backend, prompt string) bool {
+	s, err := InputText(backend, prompt+" [y/N] ")
+	if err != nil {
+		return false
+	}
+	s = strings.ToLower(strings.TrimSpace(s))
+	return s == "y" || s == "yes"
+}
+
+// BookRow is the subset of book data BookListView needs to render a row;
+// it exists so the ui package does not import the library package.
+type BookRow struct {
+	ID         int
+	Title      string
+	Author     string
+	Year       int
+	IsBorrowed bool
+}
+
+// BookListView renders a scrollable, navigable list of books. Up/Down move
+// the cursor; Enter returns the ID of the selected row.
+func BookListView(backend Backend, title string, rows []BookRow) (id int, err error) {
+	labels := make([]string, len(rows))
+	for i, r := range rows {
+		status := "available"
+		if r.IsBorrowed {
+			status = "borrowed"
+		}
+		labels[i] = fmt.Sprintf("[%d] %s by %s (%d) - %s", r.ID, r.Title, r.Author, r.Year, status)
+	}
+
+	i, err := SelectFromList(backend, title, labels)
+	if err != nil {
+		return 0, err
+	}
+	return rows[i].ID, nil
+}
@@ -0,0 +1,165 @@
+package ui
+
+import "testing"
+
+// stubBackend feeds a canned sequence of keys and records everything
+// written to it, so widgets can be tested without a real tty.
+type stubBackend struct {
+	keys    []Key
+	pos     int
+	written string
+}
+
+func (s *stubBackend) ReadKey() (Key, error) {
+	if s.pos >= len(s.keys) {
+		return Key{}, errEndOfInput
+	}
+	k := s.keys[s.pos]
+	s.pos++
+	return k, nil
+}
+
+func (s *stubBackend) Write(str string) { s.written += str }
+func (s *stubBackend) Size() (int, int) { return 80, 24 }
+func (s *stubBackend) Close() error     { return nil }
+
+var errEndOfInput = &stubError{"stub: out of keys"}
+
+type stubError struct{ msg string }
+
+func (e *stubError) Error() string { return e.msg }
+
+func keysFor(s string) []Key {
+	keys := make([]Key, 0, len(s)+1)
+	for _, r := range s {
+		keys = append(keys, Key{Rune: r})
+	}
+	return append(keys, Key{Special: KeyEnter})
+}
+
+func TestSelectFromListNavigatesDownAndSelects(t *testing.T) {
+	b := &stubBackend{keys: []Key{
+		{Special: KeyDown},
+		{Special: KeyDown},
+		{Special: KeyEnter},
+	}}
+
+	got, err := SelectFromList(b, "Pick one", []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatalf("SelectFromList returned error: %v", err)
+	}
+	if got != 2 {
+		t.Fatalf("SelectFromList = %d; want 2", got)
+	}
+}
+
+func TestSelectFromListNavigatesWithRuneFallback(t *testing.T) {
+	b := &stubBackend{keys: []Key{
+		{Rune: 'd'},
+		{Rune: 'd'},
+		{Rune: 'u'},
+		{Special: KeyEnter},
+	}}
+
+	got, err := SelectFromList(b, "Pick one", []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatalf("SelectFromList returned error: %v", err)
+	}
+	if got != 1 {
+		t.Fatalf("SelectFromList = %d; want 1", got)
+	}
+}
+
+func TestSelectFromListClampsAtBounds(t *testing.T) {
+	b := &stubBackend{keys: []Key{
+		{Special: KeyUp},
+		{Special: KeyUp},
+		{Special: KeyEnter},
+	}}
+
+	got, err := SelectFromList(b, "Pick one", []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatalf("SelectFromList returned error: %v", err)
+	}
+	if got != 0 {
+		t.Fatalf("SelectFromList = %d; want 0 (clamped)", got)
+	}
+}
+
+func TestSelectFromListEscapeCancels(t *testing.T) {
+	b := &stubBackend{keys: []Key{{Special: KeyEscape}}}
+
+	if _, err := SelectFromList(b, "Pick one", []string{"a", "b"}); err == nil {
+		t.Fatalf("SelectFromList returned nil error after Escape")
+	}
+}
+
+func TestInputText(t *testing.T) {
+	b := &stubBackend{keys: keysFor("Dune")}
+
+	got, err := InputText(b, "Title: ")
+	if err != nil {
+		t.Fatalf("InputText returned error: %v", err)
+	}
+	if got != "Dune" {
+		t.Fatalf("InputText = %q; want %q", got, "Dune")
+	}
+}
+
+func TestInputTextBackspace(t *testing.T) {
+	b := &stubBackend{keys: []Key{
+		{Rune: 'D'}, {Rune: 'x'}, {Special: KeyBackspace}, {Rune: 'u'}, {Rune: 'n'}, {Rune: 'e'}, {Special: KeyEnter},
+	}}
+
+	got, err := InputText(b, "Title: ")
+	if err != nil {
+		t.Fatalf("InputText returned error: %v", err)
+	}
+	if got != "Dune" {
+		t.Fatalf("InputText = %q; want %q", got, "Dune")
+	}
+}
+
+func TestInputIntReprompts(t *testing.T) {
+	b := &stubBackend{keys: append(keysFor("abc"), keysFor("1965")...)}
+
+	got, err := InputInt(b, "Year: ")
+	if err != nil {
+		t.Fatalf("InputInt returned error: %v", err)
+	}
+	if got != 1965 {
+		t.Fatalf("InputInt = %d; want 1965", got)
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	b := &stubBackend{keys: keysFor("y")}
+
+	if !Confirm(b, "Sure?") {
+		t.Fatalf("Confirm = false; want true")
+	}
+}
+
+func TestConfirmDefaultsToFalse(t *testing.T) {
+	b := &stubBackend{keys: keysFor("")}
+
+	if Confirm(b, "Sure?") {
+		t.Fatalf("Confirm = true; want false")
+	}
+}
+
+func TestBookListView(t *testing.T) {
+	b := &stubBackend{keys: []Key{{Special: KeyDown}, {Special: KeyEnter}}}
+	rows := []BookRow{
+		{ID: 1, Title: "Dune", Author: "Frank Herbert", Year: 1965},
+		{ID: 2, Title: "Foundation", Author: "Isaac Asimov", Year: 1951, IsBorrowed: true},
+	}
+
+	id, err := BookListView(b, "Catalog", rows)
+	if err != nil {
+		t.Fatalf("BookListView returned error: %v", err)
+	}
+	if id != 2 {
+		t.Fatalf("BookListView = %d; want 2", id)
+	}
+}